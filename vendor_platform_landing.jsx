@@ -157,7 +157,9 @@ export default function App(): JSX.Element {
       const res = await fetch(`/api/vendors/search?q=${encodeURIComponent(query)}`);
       if (res.ok) {
         const json = await res.json();
-        if (Array.isArray(json)) {
+        if (Array.isArray(json?.items)) {
+          setVendors(json.items as Vendor[]);
+        } else if (Array.isArray(json)) {
           // previous simple backend returned array
           setVendors(json as Vendor[]);
         } else if (json?.vendors && Array.isArray(json.vendors)) {